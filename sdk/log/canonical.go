@@ -0,0 +1,192 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/binary"
+	"math"
+	"slices"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// canonicalVersion identifies the layout of the encoding produced by
+// CanonicalBytes. It must be incremented whenever that layout changes.
+const canonicalVersion = 1
+
+// CanonicalBytes returns a deterministic binary encoding of the record.
+//
+// Records holding the same data produce the same encoding regardless of the
+// order their attributes were added in or the location and monotonic clock
+// reading of their timestamps. Attributes, map values, and resource and
+// instrumentation scope attributes are ordered by key. Attributes and map
+// values sharing a key, as allowed by [WithAllowKeyDuplication], are ordered
+// by their encoded value. Timestamps are encoded as a presence flag followed
+// by seconds and nanoseconds since the Unix epoch, and all NaN float values
+// are encoded identically.
+//
+// The encoding covers the ID, event name, timestamps, severity, severity text,
+// body, attributes, and trace context of the record, as well as the schema URL
+// and attributes of its resource and the name, version, schema URL, and
+// attributes of its instrumentation scope. An unset resource or scope is
+// encoded the same as an empty one. The dropped attribute count is not
+// covered.
+//
+// The encoding is meant to be hashed or signed, not decoded. Its first byte
+// is a version number that changes whenever the layout changes.
+func (r *Record) CanonicalBytes() []byte {
+	buf := make([]byte, 0, 128)
+	buf = append(buf, canonicalVersion)
+	buf = append(buf, r.id[:]...)
+	buf = appendString(buf, r.eventName)
+	buf = appendTime(buf, r.timestamp)
+	buf = appendTime(buf, r.observedTimestamp)
+	buf = binary.BigEndian.AppendUint64(buf, uint64(r.severity)) // nolint:gosec  // Encoding the bits.
+	buf = appendString(buf, r.severityText)
+	buf = appendValue(buf, r.body)
+
+	attrs := make([]attribute.KeyValue, 0, r.AttributesLen())
+	r.WalkAttributes(func(kv attribute.KeyValue) bool {
+		attrs = append(attrs, kv)
+		return true
+	})
+	buf = appendKeyValues(buf, attrs)
+
+	buf = append(buf, r.traceID[:]...)
+	buf = append(buf, r.spanID[:]...)
+	buf = append(buf, byte(r.traceFlags))
+
+	buf = appendString(buf, r.resource.SchemaURL())
+	buf = appendSet(buf, r.resource.Set())
+
+	scope := r.InstrumentationScope()
+	buf = appendString(buf, scope.Name)
+	buf = appendString(buf, scope.Version)
+	buf = appendString(buf, scope.SchemaURL)
+	return appendSet(buf, &scope.Attributes)
+}
+
+// appendTime appends whether t is set followed, if it is, by its seconds and
+// nanoseconds since the Unix epoch. Unlike UnixNano, this is defined for all
+// times.
+func appendTime(buf []byte, t time.Time) []byte {
+	if t.IsZero() {
+		return append(buf, 0)
+	}
+	buf = append(buf, 1)
+	buf = binary.BigEndian.AppendUint64(buf, uint64(t.Unix()))        // nolint:gosec  // Encoding the bits.
+	return binary.BigEndian.AppendUint32(buf, uint32(t.Nanosecond())) // nolint:gosec  // Nanosecond is within [0, 1e9).
+}
+
+func appendString(buf []byte, s string) []byte {
+	buf = binary.AppendUvarint(buf, uint64(len(s)))
+	return append(buf, s...)
+}
+
+func appendFloat(buf []byte, f float64) []byte {
+	if math.IsNaN(f) {
+		f = math.NaN()
+	}
+	return binary.BigEndian.AppendUint64(buf, math.Float64bits(f))
+}
+
+// appendKeyValues sorts kvs in place and appends them.
+func appendKeyValues(buf []byte, kvs []attribute.KeyValue) []byte {
+	slices.SortFunc(kvs, compareKeyValues)
+	buf = binary.AppendUvarint(buf, uint64(len(kvs)))
+	for _, kv := range kvs {
+		buf = appendString(buf, string(kv.Key))
+		buf = appendValue(buf, kv.Value)
+	}
+	return buf
+}
+
+// compareKeyValues orders a and b by key and then, for duplicate keys, by
+// their encoded value.
+func compareKeyValues(a, b attribute.KeyValue) int {
+	if c := cmp.Compare(a.Key, b.Key); c != 0 {
+		return c
+	}
+	return bytes.Compare(appendValue(nil, a.Value), appendValue(nil, b.Value))
+}
+
+// appendSet appends the attributes of s, which are ordered by key.
+func appendSet(buf []byte, s *attribute.Set) []byte {
+	buf = binary.AppendUvarint(buf, uint64(s.Len())) // nolint:gosec  // Len is non-negative.
+	for iter := s.Iter(); iter.Next(); {
+		kv := iter.Attribute()
+		buf = appendString(buf, string(kv.Key))
+		buf = appendValue(buf, kv.Value)
+	}
+	return buf
+}
+
+// appendValue appends the type of v followed by its length-prefixed data.
+func appendValue(buf []byte, v attribute.Value) []byte {
+	buf = append(buf, byte(v.Type()))
+	switch v.Type() {
+	case attribute.BOOL:
+		if v.AsBool() {
+			return append(buf, 1)
+		}
+		return append(buf, 0)
+	case attribute.INT64:
+		return binary.BigEndian.AppendUint64(buf, uint64(v.AsInt64())) // nolint:gosec  // Encoding the bits.
+	case attribute.FLOAT64:
+		return appendFloat(buf, v.AsFloat64())
+	case attribute.STRING:
+		return appendString(buf, v.AsString())
+	case attribute.BYTESLICE:
+		b := v.AsByteSlice()
+		buf = binary.AppendUvarint(buf, uint64(len(b)))
+		return append(buf, b...)
+	case attribute.BOOLSLICE:
+		s := v.AsBoolSlice()
+		buf = binary.AppendUvarint(buf, uint64(len(s)))
+		for _, b := range s {
+			if b {
+				buf = append(buf, 1)
+			} else {
+				buf = append(buf, 0)
+			}
+		}
+		return buf
+	case attribute.INT64SLICE:
+		s := v.AsInt64Slice()
+		buf = binary.AppendUvarint(buf, uint64(len(s)))
+		for _, i := range s {
+			buf = binary.BigEndian.AppendUint64(buf, uint64(i)) // nolint:gosec  // Encoding the bits.
+		}
+		return buf
+	case attribute.FLOAT64SLICE:
+		s := v.AsFloat64Slice()
+		buf = binary.AppendUvarint(buf, uint64(len(s)))
+		for _, f := range s {
+			buf = appendFloat(buf, f)
+		}
+		return buf
+	case attribute.STRINGSLICE:
+		s := v.AsStringSlice()
+		buf = binary.AppendUvarint(buf, uint64(len(s)))
+		for _, str := range s {
+			buf = appendString(buf, str)
+		}
+		return buf
+	case attribute.SLICE:
+		s := v.AsSlice()
+		buf = binary.AppendUvarint(buf, uint64(len(s)))
+		for _, elem := range s {
+			buf = appendValue(buf, elem)
+		}
+		return buf
+	case attribute.MAP:
+		// AsMap returns a copy, so it can be sorted in place.
+		return appendKeyValues(buf, v.AsMap())
+	default:
+		return buf
+	}
+}
@@ -0,0 +1,255 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func canonicalTestRecord() *Record {
+	ts := time.Date(2024, time.March, 1, 2, 3, 4, 5, time.UTC)
+
+	r := &Record{attributeValueLengthLimit: -1, attributeCountLimit: -1}
+	r.SetID(RecordID{1})
+	r.SetEventName("event")
+	r.SetTimestamp(ts)
+	r.SetObservedTimestamp(ts.Add(time.Second))
+	r.SetSeverity(log.SeverityInfo)
+	r.SetSeverityText("INFO")
+	r.SetBody(attribute.MapValue(
+		attribute.String("actor", "alice"),
+		attribute.Slice("targets", attribute.StringValue("a"), attribute.Int64Value(1)),
+	))
+	r.SetAttributes(
+		attribute.String("k1", "v1"),
+		attribute.Int64("k2", 2),
+		attribute.Float64("k3", 3),
+		attribute.Bool("k4", true),
+		attribute.BoolSlice("k5", []bool{true, false}),
+		attribute.Int64Slice("k6", []int64{1, 2}),
+		attribute.Float64Slice("k7", []float64{1, 2}),
+		attribute.StringSlice("k8", []string{"a", "b"}),
+		attribute.ByteSlice("k9", []byte("bytes")),
+	)
+	r.SetTraceID(trace.TraceID{2})
+	r.SetSpanID(trace.SpanID{3})
+	r.SetTraceFlags(trace.FlagsSampled)
+	r.resource = resource.NewWithAttributes(
+		"https://opentelemetry.io/schemas/1.0.0",
+		attribute.String("service.name", "svc"),
+		attribute.String("service.version", "1.0.0"),
+	)
+	r.scope = &instrumentation.Scope{
+		Name:       "scope",
+		Version:    "v0.1.0",
+		SchemaURL:  "https://opentelemetry.io/schemas/1.0.0",
+		Attributes: attribute.NewSet(attribute.Bool("scope.attr", true)),
+	}
+	return r
+}
+
+func TestRecordCanonicalBytesVersion(t *testing.T) {
+	r := new(Record)
+	assert.Equal(t, byte(canonicalVersion), r.CanonicalBytes()[0])
+}
+
+func TestRecordCanonicalBytesDeterministic(t *testing.T) {
+	want := canonicalTestRecord().CanonicalBytes()
+
+	tests := []struct {
+		name   string
+		mutate func(*Record)
+	}{
+		{
+			name: "attribute order",
+			mutate: func(r *Record) {
+				var attrs []attribute.KeyValue
+				r.WalkAttributes(func(kv attribute.KeyValue) bool {
+					attrs = append([]attribute.KeyValue{kv}, attrs...)
+					return true
+				})
+				r.SetAttributes(attrs...)
+			},
+		},
+		{
+			name: "body map order",
+			mutate: func(r *Record) {
+				r.SetBody(attribute.MapValue(
+					attribute.Slice("targets", attribute.StringValue("a"), attribute.Int64Value(1)),
+					attribute.String("actor", "alice"),
+				))
+			},
+		},
+		{
+			name: "timestamp location",
+			mutate: func(r *Record) {
+				r.SetTimestamp(r.Timestamp().In(time.FixedZone("test", 3600)))
+			},
+		},
+		{
+			name: "resource attribute order",
+			mutate: func(r *Record) {
+				r.resource = resource.NewWithAttributes(
+					"https://opentelemetry.io/schemas/1.0.0",
+					attribute.String("service.version", "1.0.0"),
+					attribute.String("service.name", "svc"),
+				)
+			},
+		},
+		{
+			name: "dropped attributes",
+			mutate: func(r *Record) {
+				r.dropped = 1
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := canonicalTestRecord()
+			tc.mutate(r)
+			assert.Equal(t, want, r.CanonicalBytes())
+		})
+	}
+}
+
+func TestRecordCanonicalBytesDuplicateKeys(t *testing.T) {
+	newRecord := func(kvs ...attribute.KeyValue) *Record {
+		r := &Record{attributeValueLengthLimit: -1, attributeCountLimit: -1, allowDupKeys: true}
+		r.SetAttributes(kvs...)
+		r.SetBody(attribute.MapValue(kvs...))
+		return r
+	}
+
+	k1, k2 := attribute.Int("k", 1), attribute.Int("k", 2)
+	r0, r1 := newRecord(k1, k2), newRecord(k2, k1)
+	assert.Equal(t, r0.CanonicalBytes(), r1.CanonicalBytes())
+	assert.NotEqual(t, r0.CanonicalBytes(), newRecord(k1, k1).CanonicalBytes())
+}
+
+func TestRecordCanonicalBytesUnsetResourceAndScope(t *testing.T) {
+	r0, r1 := new(Record), new(Record)
+	r1.resource = resource.Empty()
+	r1.scope = &instrumentation.Scope{}
+	assert.Equal(t, r0.CanonicalBytes(), r1.CanonicalBytes())
+}
+
+func TestRecordCanonicalBytesMonotonicClock(t *testing.T) {
+	ts := time.Now()
+
+	r0, r1 := new(Record), new(Record)
+	r0.SetTimestamp(ts)
+	r1.SetTimestamp(ts.Round(0))
+	assert.Equal(t, r0.CanonicalBytes(), r1.CanonicalBytes())
+}
+
+func TestRecordCanonicalBytesTimestamp(t *testing.T) {
+	tests := []struct {
+		name   string
+		t0, t1 time.Time
+	}{
+		{
+			name: "unset and Unix epoch",
+			t0:   time.Time{},
+			t1:   time.Unix(0, 0),
+		},
+		{
+			name: "after UnixNano range",
+			t0:   time.Date(2500, time.January, 1, 0, 0, 0, 0, time.UTC),
+			t1:   time.Date(2500, time.January, 1, 0, 0, 0, 1, time.UTC),
+		},
+		{
+			name: "before UnixNano range",
+			t0:   time.Date(1500, time.January, 1, 0, 0, 0, 0, time.UTC),
+			t1:   time.Date(1500, time.January, 1, 0, 0, 0, 1, time.UTC),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r0, r1 := new(Record), new(Record)
+			r0.SetTimestamp(tc.t0)
+			r1.SetTimestamp(tc.t1)
+			assert.NotEqual(t, r0.CanonicalBytes(), r1.CanonicalBytes())
+		})
+	}
+}
+
+func TestRecordCanonicalBytesNaN(t *testing.T) {
+	r0, r1 := new(Record), new(Record)
+	r0.SetBody(attribute.Float64Value(math.NaN()))
+	r1.SetBody(attribute.Float64Value(math.Float64frombits(0x7ff8000000000002)))
+	assert.Equal(t, r0.CanonicalBytes(), r1.CanonicalBytes())
+}
+
+func TestRecordCanonicalBytesDistinct(t *testing.T) {
+	want := canonicalTestRecord().CanonicalBytes()
+
+	tests := []struct {
+		name   string
+		mutate func(*Record)
+	}{
+		{"id", func(r *Record) { r.SetID(RecordID{2}) }},
+		{"event name", func(r *Record) { r.SetEventName("other") }},
+		{"timestamp", func(r *Record) { r.SetTimestamp(r.Timestamp().Add(1)) }},
+		{"unset timestamp", func(r *Record) { r.SetTimestamp(time.Time{}) }},
+		{"observed timestamp", func(r *Record) { r.SetObservedTimestamp(r.ObservedTimestamp().Add(1)) }},
+		{"severity", func(r *Record) { r.SetSeverity(log.SeverityError) }},
+		{"severity text", func(r *Record) { r.SetSeverityText("ERROR") }},
+		{"body", func(r *Record) { r.SetBody(attribute.StringValue("body")) }},
+		{"body type", func(r *Record) { r.SetBody(attribute.StringSliceValue([]string{"actor", "alice"})) }},
+		{"attribute value", func(r *Record) { r.SetAttributes(attribute.String("k1", "v2")) }},
+		{"attribute key", func(r *Record) { r.AddAttributes(attribute.String("k10", "v")) }},
+		{"trace ID", func(r *Record) { r.SetTraceID(trace.TraceID{4}) }},
+		{"span ID", func(r *Record) { r.SetSpanID(trace.SpanID{4}) }},
+		{"trace flags", func(r *Record) { r.SetTraceFlags(0) }},
+		{"resource attribute", func(r *Record) {
+			r.resource = resource.NewWithAttributes(r.resource.SchemaURL(), attribute.String("service.name", "other"))
+		}},
+		{"resource schema URL", func(r *Record) {
+			r.resource = resource.NewWithAttributes("", r.resource.Attributes()...)
+		}},
+		{"unset resource", func(r *Record) { r.resource = nil }},
+		{"scope name", func(r *Record) { r.scope.Name = "other" }},
+		{"scope version", func(r *Record) { r.scope.Version = "v0.2.0" }},
+		{"scope schema URL", func(r *Record) { r.scope.SchemaURL = "" }},
+		{"scope attributes", func(r *Record) { r.scope.Attributes = *attribute.EmptySet() }},
+		{"unset scope", func(r *Record) { r.scope = nil }},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := canonicalTestRecord()
+			tc.mutate(r)
+			assert.NotEqual(t, want, r.CanonicalBytes())
+		})
+	}
+}
+
+func TestRecordCanonicalBytesLengthPrefixed(t *testing.T) {
+	r0, r1 := new(Record), new(Record)
+	r0.SetEventName("ab")
+	r1.SetEventName("a")
+	r1.SetSeverityText("b")
+	assert.NotEqual(t, r0.CanonicalBytes(), r1.CanonicalBytes())
+}
+
+func BenchmarkRecordCanonicalBytes(b *testing.B) {
+	r := canonicalTestRecord()
+
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = r.CanonicalBytes()
+	}
+}
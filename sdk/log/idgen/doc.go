@@ -0,0 +1,21 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+Package idgen provides unique identifier generators for use with the
+OpenTelemetry Logs SDK.
+
+[ULIDGenerator] produces [ULID] values (https://github.com/ulid/spec) that are
+strictly increasing for a single generator. Its values can be assigned to a
+[go.opentelemetry.io/otel/sdk/log.Record] with
+[go.opentelemetry.io/otel/sdk/log.Record.SetID] when strictly increasing
+record identifiers are required.
+
+[SnowflakeGenerator] produces 64-bit identifiers scoped to a node number. This
+is useful when several processes need to generate identifiers that are
+unique across all of them without coordination, as long as each process uses
+a distinct node.
+
+All generators are safe for concurrent use.
+*/
+package idgen // import "go.opentelemetry.io/otel/sdk/log/idgen"
@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package idgen // import "go.opentelemetry.io/otel/sdk/log/idgen"
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	snowflakeTimeBits = 41
+	snowflakeNodeBits = 10
+	snowflakeSeqBits  = 12
+
+	// MaxSnowflakeNode is the largest node number accepted by
+	// [NewSnowflakeGenerator].
+	MaxSnowflakeNode = 1<<snowflakeNodeBits - 1
+
+	snowflakeMaxMS  = 1<<snowflakeTimeBits - 1
+	snowflakeMaxSeq = 1<<snowflakeSeqBits - 1
+)
+
+// ErrSnowflakeOverflow is returned by [SnowflakeGenerator.New] when the
+// timestamp of the identifier no longer fits in 41 bits.
+var ErrSnowflakeOverflow = errors.New("snowflake timestamp exceeds 41 bits")
+
+// snowflakeEpoch is the time Snowflake timestamps are measured from.
+var snowflakeEpoch = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// SnowflakeGenerator generates 64-bit identifiers that are strictly
+// increasing and unique across all generators using a distinct node number.
+//
+// An identifier holds, from the most significant bit, a zero sign bit, 41
+// bits of milliseconds since 2024-01-01T00:00:00Z, 10 bits of node number,
+// and a 12 bit sequence number.
+//
+// When more than 4096 identifiers are requested within a millisecond, or the
+// clock moves backwards, the generator continues from the timestamp of the
+// previously generated identifier instead of blocking.
+//
+// The 41 bit timestamp runs out at 2093-09-06T15:47:35.551Z. From then on, or
+// once borrowing timestamps from the future reaches that point, no more
+// identifiers can be generated and [ErrSnowflakeOverflow] is returned.
+type SnowflakeGenerator struct {
+	node int64
+
+	mu     sync.Mutex
+	lastMS int64
+	seq    int64
+}
+
+// NewSnowflakeGenerator returns a new [SnowflakeGenerator] for node. An error
+// is returned if node is not within [0, MaxSnowflakeNode].
+func NewSnowflakeGenerator(node int) (*SnowflakeGenerator, error) {
+	if node < 0 || node > MaxSnowflakeNode {
+		return nil, fmt.Errorf("snowflake node %d out of range [0, %d]", node, MaxSnowflakeNode)
+	}
+	return &SnowflakeGenerator{node: int64(node), lastMS: -1}, nil
+}
+
+// New returns an identifier for time t that is greater than any identifier
+// previously returned by g. It returns [ErrSnowflakeOverflow] if such an
+// identifier cannot be represented.
+func (g *SnowflakeGenerator) New(t time.Time) (int64, error) {
+	ms := max(t.Sub(snowflakeEpoch).Milliseconds(), 0)
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	lastMS, seq := g.lastMS, g.seq
+	switch {
+	case ms > lastMS:
+		lastMS, seq = ms, 0
+	case seq < snowflakeMaxSeq:
+		seq++
+	default:
+		lastMS, seq = lastMS+1, 0
+	}
+	if lastMS > snowflakeMaxMS {
+		return 0, ErrSnowflakeOverflow
+	}
+
+	g.lastMS, g.seq = lastMS, seq
+	return lastMS<<(snowflakeNodeBits+snowflakeSeqBits) | g.node<<snowflakeSeqBits | seq, nil
+}
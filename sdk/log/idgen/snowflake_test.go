@@ -0,0 +1,99 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package idgen
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSnowflakeGeneratorNode(t *testing.T) {
+	_, err := NewSnowflakeGenerator(-1)
+	assert.Error(t, err)
+
+	_, err = NewSnowflakeGenerator(MaxSnowflakeNode + 1)
+	assert.Error(t, err)
+
+	_, err = NewSnowflakeGenerator(MaxSnowflakeNode)
+	assert.NoError(t, err)
+}
+
+func TestSnowflakeGeneratorNew(t *testing.T) {
+	g, err := NewSnowflakeGenerator(5)
+	require.NoError(t, err)
+
+	ts := snowflakeEpoch.Add(3 * time.Millisecond)
+	assertSnowflake(t, g, ts, 3<<22|5<<12)
+	assertSnowflake(t, g, ts, 3<<22|5<<12|1, "same millisecond")
+	assertSnowflake(t, g, ts.Add(-time.Second), 3<<22|5<<12|2, "clock moved backwards")
+	assertSnowflake(t, g, ts.Add(time.Millisecond), 4<<22|5<<12)
+}
+
+func assertSnowflake(t *testing.T, g *SnowflakeGenerator, ts time.Time, want int64, msgAndArgs ...any) {
+	t.Helper()
+	got, err := g.New(ts)
+	require.NoError(t, err, msgAndArgs...)
+	assert.Equal(t, want, got, msgAndArgs...)
+}
+
+func TestSnowflakeGeneratorSequenceOverflow(t *testing.T) {
+	g, err := NewSnowflakeGenerator(1)
+	require.NoError(t, err)
+
+	ts := snowflakeEpoch
+	var last int64
+	for range snowflakeMaxSeq + 1 {
+		id, err := g.New(ts)
+		require.NoError(t, err)
+		require.Greater(t, id, last)
+		last = id
+	}
+	assertSnowflake(t, g, ts, 1<<22|1<<12)
+}
+
+func TestSnowflakeGeneratorTimestampLimit(t *testing.T) {
+	limit := time.Date(2093, time.September, 6, 15, 47, 35, 551_000_000, time.UTC)
+
+	g, err := NewSnowflakeGenerator(MaxSnowflakeNode)
+	require.NoError(t, err)
+
+	id, err := g.New(limit)
+	require.NoError(t, err)
+	assert.Equal(t, int64(math.MaxInt64-snowflakeMaxSeq), id)
+
+	_, err = g.New(limit.Add(time.Millisecond))
+	assert.ErrorIs(t, err, ErrSnowflakeOverflow)
+
+	// Exhaust the sequence of the last representable millisecond.
+	for range snowflakeMaxSeq {
+		id, err = g.New(limit)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int64(math.MaxInt64), id)
+
+	_, err = g.New(limit)
+	assert.ErrorIs(t, err, ErrSnowflakeOverflow, "borrowing past the limit")
+
+	// A failed call does not change the state of the generator.
+	_, err = g.New(limit)
+	assert.ErrorIs(t, err, ErrSnowflakeOverflow)
+}
+
+func TestSnowflakeGeneratorNodesUnique(t *testing.T) {
+	g0, err := NewSnowflakeGenerator(0)
+	require.NoError(t, err)
+	g1, err := NewSnowflakeGenerator(1)
+	require.NoError(t, err)
+
+	ts := time.Now()
+	id0, err := g0.New(ts)
+	require.NoError(t, err)
+	id1, err := g1.New(ts)
+	require.NoError(t, err)
+	assert.NotEqual(t, id0, id1)
+}
@@ -0,0 +1,110 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package idgen // import "go.opentelemetry.io/otel/sdk/log/idgen"
+
+import (
+	"encoding/binary"
+	"errors"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/sdk/log/internal/ulid"
+)
+
+// crockford is the Crockford base32 alphabet used to encode ULIDs.
+const crockford = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// ErrULIDOverflow is returned by [ULIDGenerator.New] when the timestamp of the
+// ULID no longer fits in 48 bits.
+var ErrULIDOverflow = errors.New("ULID timestamp exceeds 48 bits")
+
+// ULID is a Universally Unique Lexicographically Sortable Identifier
+// (https://github.com/ulid/spec).
+//
+// The 48 most significant bits hold a Unix timestamp in milliseconds and the
+// remaining 80 bits hold entropy. Both are stored in big-endian byte order.
+type ULID [16]byte
+
+// Time returns the timestamp encoded in u with millisecond precision.
+func (u ULID) Time() time.Time {
+	return time.UnixMilli(int64(ulid.Timestamp(u))) // nolint:gosec  // 48-bit value.
+}
+
+// String returns the 26 character Crockford base32 encoding of u.
+func (u ULID) String() string {
+	hi := binary.BigEndian.Uint64(u[:8])
+	lo := binary.BigEndian.Uint64(u[8:])
+
+	// 26 characters hold 130 bits, the 2 most significant are always zero.
+	var dst [26]byte
+	for i := len(dst) - 1; i >= 0; i-- {
+		dst[i] = crockford[lo&0x1f]
+		lo = lo>>5 | hi<<59
+		hi >>= 5
+	}
+	return string(dst[:])
+}
+
+// ULIDGenerator generates ULIDs that are strictly increasing.
+//
+// When a ULID is requested for the same millisecond as the previously
+// generated one, or for an earlier millisecond because the clock moved
+// backwards, the entropy of the previous ULID is incremented by one instead
+// of being drawn at random. If the entropy overflows, the timestamp of the
+// previous ULID is advanced by one millisecond.
+//
+// Times before the Unix epoch are treated as the epoch. The 48 bit timestamp
+// runs out at 10889-08-02T05:31:50.655Z. From then on, or once advancing the
+// timestamp reaches that point, no more ULIDs can be generated and
+// [ErrULIDOverflow] is returned.
+//
+// The zero value is ready for use.
+type ULIDGenerator struct {
+	mu   sync.Mutex
+	last ULID
+}
+
+// NewULIDGenerator returns a new [ULIDGenerator].
+func NewULIDGenerator() *ULIDGenerator {
+	return &ULIDGenerator{}
+}
+
+// New returns a ULID for time t that is greater than any ULID previously
+// returned by g. It returns [ErrULIDOverflow] if such a ULID cannot be
+// represented.
+func (g *ULIDGenerator) New(t time.Time) (ULID, error) {
+	ms, ok := ulid.Millis(t)
+	if !ok {
+		return ULID{}, ErrULIDOverflow
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if lastMS := ulid.Timestamp(g.last); g.last != (ULID{}) && ms <= lastMS {
+		if next, ok := increment(g.last); ok {
+			g.last = next
+			return next, nil
+		}
+		if lastMS == ulid.MaxMillis {
+			return ULID{}, ErrULIDOverflow
+		}
+		ms = lastMS + 1
+	}
+
+	g.last = ulid.New(ms)
+	return g.last, nil
+}
+
+// increment returns u with its entropy incremented by one. It returns false
+// if the entropy overflows.
+func increment(u ULID) (ULID, bool) {
+	for i := len(u) - 1; i >= 6; i-- {
+		u[i]++
+		if u[i] != 0 {
+			return u, true
+		}
+	}
+	return u, false
+}
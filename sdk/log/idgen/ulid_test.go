@@ -0,0 +1,170 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package idgen
+
+import (
+	"bytes"
+	"encoding/hex"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestULIDString(t *testing.T) {
+	spec, err := hex.DecodeString("01563e3ab5d3d6764c61efb99302bd5b")
+	require.NoError(t, err)
+
+	var maxID ULID
+	for i := range maxID {
+		maxID[i] = 0xff
+	}
+
+	tests := []struct {
+		name string
+		id   ULID
+		want string
+	}{
+		{
+			name: "zero",
+			id:   ULID{},
+			want: "00000000000000000000000000",
+		},
+		{
+			name: "max",
+			id:   maxID,
+			want: "7ZZZZZZZZZZZZZZZZZZZZZZZZZ",
+		},
+		{
+			name: "spec example",
+			id:   ULID(spec),
+			want: "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.id.String())
+		})
+	}
+}
+
+func TestULIDGeneratorNew(t *testing.T) {
+	ts := time.UnixMilli(1469918176385)
+
+	id, err := NewULIDGenerator().New(ts)
+	require.NoError(t, err)
+	assert.Equal(t, ts, id.Time())
+	// The first 10 characters encode the millisecond timestamp.
+	assert.Equal(t, "01ARYZ6S41", id.String()[:10])
+}
+
+func TestULIDGeneratorMonotonic(t *testing.T) {
+	ts := time.UnixMilli(1469918176385)
+
+	var g ULIDGenerator
+	first, err := g.New(ts)
+	require.NoError(t, err)
+
+	second, err := g.New(ts)
+	require.NoError(t, err)
+	assert.Equal(t, ts, second.Time())
+	assert.Equal(t, 1, bytes.Compare(second[:], first[:]), "same millisecond")
+
+	third, err := g.New(ts.Add(-time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, ts, third.Time())
+	assert.Equal(t, 1, bytes.Compare(third[:], second[:]), "clock moved backwards")
+}
+
+func TestULIDGeneratorEntropyOverflow(t *testing.T) {
+	ts := time.UnixMilli(1469918176385)
+
+	var g ULIDGenerator
+	_, err := g.New(ts)
+	require.NoError(t, err)
+	for i := 6; i < len(g.last); i++ {
+		g.last[i] = 0xff
+	}
+
+	id, err := g.New(ts)
+	require.NoError(t, err)
+	assert.Equal(t, ts.Add(time.Millisecond), id.Time())
+}
+
+func TestULIDGeneratorBeforeEpoch(t *testing.T) {
+	var g ULIDGenerator
+	id, err := g.New(time.Unix(-1, 0))
+	require.NoError(t, err)
+	assert.Equal(t, time.UnixMilli(0), id.Time())
+
+	ts := time.UnixMilli(1469918176385)
+	id, err = g.New(ts)
+	require.NoError(t, err)
+	assert.Equal(t, ts, id.Time(), "generator stuck after a time before the epoch")
+}
+
+func TestULIDGeneratorTimestampLimit(t *testing.T) {
+	limit := time.UnixMilli(1<<48 - 1)
+
+	var g ULIDGenerator
+	first, err := g.New(limit)
+	require.NoError(t, err)
+	assert.Equal(t, limit, first.Time())
+
+	_, err = g.New(limit.Add(time.Millisecond))
+	assert.ErrorIs(t, err, ErrULIDOverflow)
+
+	// Exhaust the entropy of the last millisecond.
+	for i := 6; i < len(g.last); i++ {
+		g.last[i] = 0xff
+	}
+	last := g.last
+	_, err = g.New(limit)
+	assert.ErrorIs(t, err, ErrULIDOverflow, "advanced timestamp past the limit")
+	assert.Equal(t, last, g.last, "failed call modified the generator")
+}
+
+func TestULIDGeneratorConcurrentSafe(t *testing.T) {
+	const goroutines, n = 8, 1000
+
+	g := NewULIDGenerator()
+	ts := time.Now()
+
+	var (
+		mu   sync.Mutex
+		seen = make(map[ULID]struct{}, goroutines*n)
+		wg   sync.WaitGroup
+	)
+	for range goroutines {
+		wg.Go(func() {
+			ids := make([]ULID, n)
+			for i := range ids {
+				var err error
+				ids[i], err = g.New(ts)
+				assert.NoError(t, err)
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			for _, id := range ids {
+				seen[id] = struct{}{}
+			}
+		})
+	}
+	wg.Wait()
+
+	assert.Len(t, seen, goroutines*n)
+}
+
+func BenchmarkULIDGeneratorNew(b *testing.B) {
+	g := NewULIDGenerator()
+	ts := time.Now()
+
+	b.ReportAllocs()
+	for b.Loop() {
+		_, _ = g.New(ts)
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ulid provides the ULID (https://github.com/ulid/spec) layout shared
+// by [go.opentelemetry.io/otel/sdk/log] and its identifier generators.
+package ulid // import "go.opentelemetry.io/otel/sdk/log/internal/ulid"
+
+import (
+	"encoding/binary"
+	"math/rand/v2"
+	"time"
+)
+
+// MaxMillis is the largest millisecond timestamp a ULID can hold.
+const MaxMillis = 1<<48 - 1
+
+// limit is the first time that cannot be held by a ULID.
+var limit = time.UnixMilli(MaxMillis + 1)
+
+// Millis returns the milliseconds since the Unix epoch of t. Times before the
+// epoch are clamped to 0. If t is after the last millisecond a ULID can hold,
+// MaxMillis and false are returned.
+func Millis(t time.Time) (uint64, bool) {
+	if t.Before(time.Unix(0, 0)) {
+		return 0, true
+	}
+	if !t.Before(limit) {
+		return MaxMillis, false
+	}
+	return uint64(t.UnixMilli()), true // nolint:gosec  // Within [0, MaxMillis].
+}
+
+// Timestamp returns the millisecond timestamp held by u.
+func Timestamp(u [16]byte) uint64 {
+	return binary.BigEndian.Uint64(u[:8]) >> 16
+}
+
+// New returns a ULID holding the millisecond timestamp ms, which must not be
+// greater than MaxMillis, and random entropy.
+func New(ms uint64) [16]byte {
+	var u [16]byte
+	// The 48 most significant bits hold the millisecond timestamp.
+	binary.BigEndian.PutUint64(u[:8], ms<<16|uint64(rand.Uint32()&0xffff))
+	binary.BigEndian.PutUint64(u[8:], rand.Uint64())
+	return u
+}
@@ -0,0 +1,71 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ulid
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMillis(t *testing.T) {
+	tests := []struct {
+		name   string
+		t      time.Time
+		want   uint64
+		wantOK bool
+	}{
+		{
+			name:   "epoch",
+			t:      time.Unix(0, 0),
+			want:   0,
+			wantOK: true,
+		},
+		{
+			name:   "before epoch",
+			t:      time.Unix(-1, 0),
+			want:   0,
+			wantOK: true,
+		},
+		{
+			name:   "zero time",
+			t:      time.Time{},
+			want:   0,
+			wantOK: true,
+		},
+		{
+			name:   "spec example",
+			t:      time.UnixMilli(1469918176385),
+			want:   1469918176385,
+			wantOK: true,
+		},
+		{
+			name:   "last millisecond",
+			t:      time.UnixMilli(MaxMillis).Add(time.Millisecond - 1),
+			want:   MaxMillis,
+			wantOK: true,
+		},
+		{
+			name:   "after last millisecond",
+			t:      time.UnixMilli(MaxMillis + 1),
+			want:   MaxMillis,
+			wantOK: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := Millis(tc.t)
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.wantOK, ok)
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	u := New(MaxMillis)
+	assert.Equal(t, uint64(MaxMillis), Timestamp(u))
+	assert.NotEqual(t, u, New(MaxMillis), "random component not unique")
+}
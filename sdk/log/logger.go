@@ -119,6 +119,8 @@ func (l *logger) newRecord(ctx context.Context, r log.Record) Record {
 		newRecord.observedTimestamp = now()
 	}
 
+	newRecord.id = newRecordID(newRecord.observedTimestamp)
+
 	hasExceptionAttr := false
 	r.WalkAttributes(func(kv attribute.KeyValue) bool {
 		switch kv.Key {
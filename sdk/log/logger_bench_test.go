@@ -106,6 +106,27 @@ func BenchmarkLoggerEmitObservability(b *testing.B) {
 	require.Len(b, rm.ScopeMetrics, 1)
 }
 
+func BenchmarkLoggerNewRecord(b *testing.B) {
+	r := log.Record{}
+	r.SetTimestamp(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC))
+	r.SetBody(attribute.StringValue("testing body value"))
+	r.SetSeverity(log.SeverityInfo)
+	r.AddAttributes(
+		attribute.String("k1", "str"),
+		attribute.Float64("k2", 1.0),
+	)
+
+	l := newLogger(NewLoggerProvider(), instrumentation.Scope{})
+	ctx := b.Context()
+
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = l.newRecord(ctx, r)
+		}
+	})
+}
+
 func BenchmarkLoggerEnabled(b *testing.B) {
 	logger := newTestLogger(b)
 	ctx := b.Context()
@@ -20,6 +20,7 @@ import (
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/sdk"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/log/idgen"
 	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
@@ -40,6 +41,15 @@ func TestLoggerEmit(t *testing.T) {
 		return nowDate
 	}
 
+	id := RecordID{1}
+	newRecordIDSwap := newRecordID
+	t.Cleanup(func() {
+		newRecordID = newRecordIDSwap
+	})
+	newRecordID = func(time.Time) RecordID {
+		return id
+	}
+
 	p0, p1, p2WithError := newProcessor("0"), newProcessor("1"), newProcessor("2")
 	p2WithError.Err = errors.New("error")
 
@@ -108,6 +118,7 @@ func TestLoggerEmit(t *testing.T) {
 			record: r,
 			expectedRecords: []Record{
 				{
+					id:                        id,
 					eventName:                 r.EventName(),
 					timestamp:                 r.Timestamp(),
 					body:                      r.Body(),
@@ -149,6 +160,7 @@ func TestLoggerEmit(t *testing.T) {
 			record: r,
 			expectedRecords: []Record{
 				{
+					id:                        id,
 					eventName:                 r.EventName(),
 					timestamp:                 r.Timestamp(),
 					body:                      r.Body(),
@@ -183,6 +195,7 @@ func TestLoggerEmit(t *testing.T) {
 			record: r,
 			expectedRecords: []Record{
 				{
+					id:                        id,
 					eventName:                 r.EventName(),
 					timestamp:                 r.Timestamp(),
 					body:                      r.Body(),
@@ -214,6 +227,7 @@ func TestLoggerEmit(t *testing.T) {
 			record: rWithNoObservedTimestamp,
 			expectedRecords: []Record{
 				{
+					id:                        id,
 					eventName:                 rWithNoObservedTimestamp.EventName(),
 					timestamp:                 rWithNoObservedTimestamp.Timestamp(),
 					body:                      rWithNoObservedTimestamp.Body(),
@@ -246,6 +260,7 @@ func TestLoggerEmit(t *testing.T) {
 			record: rWithAllowKeyDuplication,
 			expectedRecords: []Record{
 				{
+					id:                        id,
 					eventName:                 rWithAllowKeyDuplication.EventName(),
 					timestamp:                 rWithAllowKeyDuplication.Timestamp(),
 					body:                      rWithAllowKeyDuplication.Body(),
@@ -279,6 +294,7 @@ func TestLoggerEmit(t *testing.T) {
 			record: rWithDuplicatesInBody,
 			expectedRecords: []Record{
 				{
+					id:        id,
 					eventName: rWithDuplicatesInBody.EventName(),
 					timestamp: rWithDuplicatesInBody.Timestamp(),
 					body: attribute.MapValue(
@@ -316,6 +332,37 @@ func TestLoggerEmit(t *testing.T) {
 	}
 }
 
+func TestLoggerEmitAssignsRecordID(t *testing.T) {
+	nowDate := time.Date(2010, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	nowSwap := now
+	t.Cleanup(func() {
+		now = nowSwap
+	})
+	now = func() time.Time {
+		return nowDate
+	}
+
+	observed := time.Date(2001, time.January, 1, 0, 0, 0, 0, time.UTC)
+	var withObserved log.Record
+	withObserved.SetObservedTimestamp(observed)
+
+	p := newProcessor("0")
+	l := newLogger(NewLoggerProvider(WithProcessor(p)), instrumentation.Scope{})
+	l.Emit(t.Context(), log.Record{})
+	l.Emit(t.Context(), withObserved)
+
+	require.Len(t, p.records, 2)
+	id0, id1 := p.records[0].ID(), p.records[1].ID()
+	assert.True(t, id0.IsValid())
+	assert.True(t, id1.IsValid())
+	assert.NotEqual(t, id0, id1)
+
+	// The ULID timestamp is the observed timestamp of the record.
+	assert.Equal(t, nowDate, idgen.ULID(id0).Time().UTC())
+	assert.Equal(t, observed, idgen.ULID(id1).Time().UTC())
+}
+
 func TestNewRecordAddsExceptionAttrs(t *testing.T) {
 	l := newLogger(NewLoggerProvider(), instrumentation.Scope{})
 
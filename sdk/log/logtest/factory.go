@@ -23,6 +23,7 @@ import (
 //
 // Do not use RecordFactory to create records in production code.
 type RecordFactory struct {
+	ID                sdklog.RecordID
 	EventName         string
 	Timestamp         time.Time
 	ObservedTimestamp time.Time
@@ -51,6 +52,7 @@ func (f RecordFactory) NewRecord() sdklog.Record {
 	set(r, "attributeCountLimit", -1)
 	set(r, "attributeValueLengthLimit", -1)
 
+	r.SetID(f.ID)
 	r.SetEventName(f.EventName)
 	r.SetTimestamp(f.Timestamp)
 	r.SetObservedTimestamp(f.ObservedTimestamp)
@@ -22,6 +22,7 @@ func TestRecordFactoryEmpty(t *testing.T) {
 }
 
 func TestRecordFactory(t *testing.T) {
+	id := sdklog.RecordID{1}
 	now := time.Now()
 	observed := now.Add(time.Second)
 	eventName := "testing.name"
@@ -43,6 +44,7 @@ func TestRecordFactory(t *testing.T) {
 	r := resource.NewSchemaless(attribute.Bool("works", true))
 
 	got := RecordFactory{
+		ID:                   id,
 		EventName:            eventName,
 		Timestamp:            now,
 		ObservedTimestamp:    observed,
@@ -58,6 +60,7 @@ func TestRecordFactory(t *testing.T) {
 		Resource:             r,
 	}.NewRecord()
 
+	assert.Equal(t, id, got.ID())
 	assert.Equal(t, eventName, got.EventName())
 	assert.Equal(t, now, got.Timestamp())
 	assert.Equal(t, observed, got.ObservedTimestamp())
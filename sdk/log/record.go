@@ -78,6 +78,7 @@ type Record struct {
 	// Do not embed the log.Record. Attributes need to be overwrite-able and
 	// deep-copying needs to be possible.
 
+	id                RecordID
 	eventName         string
 	timestamp         time.Time
 	observedTimestamp time.Time
@@ -133,6 +134,16 @@ func (r *Record) addDropped(n int) {
 	}
 }
 
+// ID returns the identifier of the log record.
+func (r *Record) ID() RecordID {
+	return r.id
+}
+
+// SetID sets the identifier of the log record.
+func (r *Record) SetID(id RecordID) {
+	r.id = id
+}
+
 // EventName returns the event name.
 // A log record with non-empty event name is interpreted as an event record.
 func (r *Record) EventName() string {
@@ -0,0 +1,47 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import (
+	"time"
+
+	"go.opentelemetry.io/otel/sdk/log/idgen"
+	"go.opentelemetry.io/otel/sdk/log/internal/ulid"
+)
+
+// RecordID is a unique identifier of a log Record.
+//
+// Records created by a Logger are assigned a ULID
+// (https://github.com/ulid/spec) when they are emitted. The identifier is not
+// part of the OpenTelemetry log data model and is not sent by OTLP exporters.
+// It identifies a Record for processors, exporters, and any storage they use.
+type RecordID [16]byte
+
+var nilRecordID RecordID
+
+// IsValid reports whether the RecordID is valid. A valid RecordID does not
+// consist of zeros only.
+func (id RecordID) IsValid() bool {
+	return id != nilRecordID
+}
+
+// String returns the 26 character Crockford base32 encoding of id.
+func (id RecordID) String() string {
+	return idgen.ULID(id).String()
+}
+
+// newRecordID returns a new ULID for a Record created at t.
+//
+// Times before the Unix epoch are treated as the epoch, and times after the
+// last millisecond a ULID can hold are treated as that millisecond.
+//
+// It does not synchronize with other calls so it can be used on the emit hot
+// path. Use an [idgen.ULIDGenerator] when strictly increasing identifiers are
+// required.
+//
+// It is a variable so tests can make record identifiers deterministic.
+var newRecordID = func(t time.Time) RecordID {
+	ms, _ := ulid.Millis(t)
+	return RecordID(ulid.New(ms))
+}
@@ -0,0 +1,57 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/sdk/log/idgen"
+)
+
+func TestRecordIDIsValid(t *testing.T) {
+	assert.False(t, RecordID{}.IsValid())
+	assert.True(t, RecordID{1}.IsValid())
+}
+
+func TestRecordIDString(t *testing.T) {
+	id := RecordID{1, 2, 3}
+	assert.Equal(t, idgen.ULID(id).String(), id.String())
+}
+
+func TestNewRecordID(t *testing.T) {
+	maxTime := time.UnixMilli(1<<48 - 1)
+
+	tests := []struct {
+		name string
+		t    time.Time
+		want time.Time
+	}{
+		{
+			name: "in range",
+			t:    time.UnixMilli(1469918176385),
+			want: time.UnixMilli(1469918176385),
+		},
+		{
+			name: "before epoch",
+			t:    time.Unix(-1, 0),
+			want: time.UnixMilli(0),
+		},
+		{
+			name: "after max",
+			t:    maxTime.Add(time.Hour),
+			want: maxTime,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			id := newRecordID(tc.t)
+			assert.Equal(t, tc.want, idgen.ULID(id).Time())
+			assert.NotEqual(t, id, newRecordID(tc.t), "random component not unique")
+		})
+	}
+}
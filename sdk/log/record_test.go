@@ -31,6 +31,14 @@ func keyValueEqual(a, b attribute.KeyValue) bool {
 	return a.Key == b.Key && valueEqual(a.Value, b.Value)
 }
 
+func TestRecordID(t *testing.T) {
+	id := RecordID{1}
+
+	r := new(Record)
+	r.SetID(id)
+	assert.Equal(t, id, r.ID())
+}
+
 func TestRecordEventName(t *testing.T) {
 	const text = "testing text"
 